@@ -0,0 +1,585 @@
+package vm
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// bigFloatDefaultPrecisionFallback is the mantissa precision (in bits) a
+// `BigFloat` gets when it's created without an explicit `prec:` keyword
+// argument and its VM hasn't set its own default. That default is
+// per-VM rather than a single package-level var — `BigFloat.default_precision=`
+// in one VM must not leak into another VM running in the same process (tests
+// routinely create several), and a plain `uint` read/written from multiple
+// goroutines would be a data race.
+const bigFloatDefaultPrecisionFallback uint = 256
+
+var (
+	bigFloatPrecisionMu   sync.RWMutex
+	bigFloatPrecisionByVM = map[*VM]uint{}
+)
+
+// defaultBigFloatPrecision returns this VM's configured default BigFloat
+// precision, or bigFloatDefaultPrecisionFallback if it hasn't set one.
+func (vm *VM) defaultBigFloatPrecision() uint {
+	bigFloatPrecisionMu.RLock()
+	defer bigFloatPrecisionMu.RUnlock()
+
+	if prec, ok := bigFloatPrecisionByVM[vm]; ok {
+		return prec
+	}
+	return bigFloatDefaultPrecisionFallback
+}
+
+// setDefaultBigFloatPrecision sets this VM's default BigFloat precision, used
+// by `BigFloat.default_precision=`.
+func (vm *VM) setDefaultBigFloatPrecision(prec uint) {
+	bigFloatPrecisionMu.Lock()
+	defer bigFloatPrecisionMu.Unlock()
+
+	bigFloatPrecisionByVM[vm] = prec
+}
+
+// bigFloatRoundingModes maps the symbol names accepted by Goby to the
+// `big.RoundingMode` constants understood by `big.Float.SetMode`.
+var bigFloatRoundingModes = map[string]big.RoundingMode{
+	"to_nearest_even": big.ToNearestEven,
+	"to_nearest_away": big.ToNearestAway,
+	"toward_zero":     big.ToZero,
+	"away_from_zero":  big.AwayFromZero,
+	"toward_positive": big.ToPositiveInf,
+	"toward_negative": big.ToNegativeInf,
+}
+
+// BigFloatObject represents an arbitrary-precision floating point number,
+// backed by `math/big.Float`. Unlike `Float`, precision does not come for
+// free: each `BigFloat` carries its own mantissa precision (in bits), which
+// defaults to `BigFloat.default_precision` (256 bits) and can be overridden
+// per instance with the `prec:` keyword argument.
+//
+// ```ruby
+// BigFloat.new("0.1") + BigFloat.new("0.2") # => 0.3
+// BigFloat.new(2, prec: 512) ** 64
+// ```
+//
+// - `BigFloat.new` is the only way to create a `BigFloat`.
+type BigFloatObject struct {
+	*BaseObj
+	value *big.Float
+}
+
+// Class methods --------------------------------------------------------
+var builtinBigFloatClassMethods = []*BuiltinMethodObject{
+	{
+		// Creates a `BigFloat` from a `String`, `Integer`, `Float` or another
+		// `BigFloat`. The mantissa precision, in bits, can be set with the
+		// `prec:` keyword argument; it defaults to `BigFloat.default_precision`.
+		// The rounding mode used once a computation exceeds that precision can
+		// be set with the `mode:` keyword argument, one of the
+		// `BigFloat::TO_NEAREST_EVEN`, `TO_NEAREST_AWAY`, `TOWARD_ZERO`,
+		// `AWAY_FROM_ZERO`, `TOWARD_POSITIVE`, or `TOWARD_NEGATIVE` constants
+		// (or the equivalent String); it defaults to `TO_NEAREST_EVEN`.
+		//
+		// ```ruby
+		// BigFloat.new("3.14159265358979323846", prec: 128)
+		// BigFloat.new(10)
+		// BigFloat.new("0.1", mode: BigFloat::TOWARD_POSITIVE)
+		// ```
+		//
+		// @return [BigFloat]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) == 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect at least 1 argument. got=0")
+			}
+
+			prec := t.vm.defaultBigFloatPrecision()
+			mode := big.ToNearestEven
+
+			for i := 1; i < len(args); i++ {
+				pair, ok := args[i].(*HashObject)
+				if !ok {
+					continue
+				}
+				if p, ok := pair.Pairs["prec"]; ok {
+					precInt, ok := p.(*IntegerObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, p.Class().Name)
+					}
+					prec = uint(precInt.value)
+				}
+				if m, ok := pair.Pairs["mode"]; ok {
+					ms, ok := m.(*StringObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, m.Class().Name)
+					}
+					rm, ok := roundingModeFor(ms.value)
+					if !ok {
+						return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Invalid mode: %s. Expect one of BigFloat's RoundingMode constants", ms.value)
+					}
+					mode = rm
+				}
+			}
+
+			bf := new(big.Float).SetPrec(prec).SetMode(mode)
+
+			switch v := args[0].(type) {
+			case *StringObject:
+				if _, ok := bf.SetString(v.value); !ok {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, v.value)
+				}
+			case *IntegerObject:
+				bf.SetInt64(int64(v.value))
+			case *FloatObject:
+				if math.IsNaN(v.value) {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, strconv.FormatFloat(v.value, 'g', -1, 64))
+				}
+				bf.SetFloat64(v.value)
+			case *BigFloatObject:
+				bf.Set(v.value)
+			default:
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "String or Numeric", args[0].Class().Name)
+			}
+
+			return t.vm.initBigFloatObject(bf)
+		},
+	},
+	{
+		// Returns the mantissa precision, in bits, used for new `BigFloat`
+		// instances when no `prec:` keyword argument is given.
+		//
+		// @return [Integer]
+		Name: "default_precision",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.InitIntegerObject(int(t.vm.defaultBigFloatPrecision()))
+		},
+	},
+	{
+		// Sets the mantissa precision, in bits, used for new `BigFloat`
+		// instances when no `prec:` keyword argument is given.
+		//
+		// @return [Integer]
+		Name: "default_precision=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			precInt, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			t.vm.setDefaultBigFloatPrecision(uint(precInt.value))
+			return args[0]
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinBigFloatInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the sum of self and a Numeric, using the larger of the two
+		// operands' precision.
+		//
+		// @return [BigFloat]
+		Name: "+",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Float) *big.Float {
+				return result.Add(left, right)
+			}
+
+			return receiver.(*BigFloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns the subtraction of a Numeric from self.
+		//
+		// @return [BigFloat]
+		Name: "-",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Float) *big.Float {
+				return result.Sub(left, right)
+			}
+
+			return receiver.(*BigFloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns self multiplied by a Numeric.
+		//
+		// @return [BigFloat]
+		Name: "*",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Float) *big.Float {
+				return result.Mul(left, right)
+			}
+
+			return receiver.(*BigFloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns self divided by a Numeric.
+		//
+		// @return [BigFloat]
+		Name: "/",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Float) *big.Float {
+				return result.Quo(left, right)
+			}
+
+			return receiver.(*BigFloatObject).arithmeticOperation(t, args[0], operation, sourceLine, true)
+		},
+	},
+	{
+		// Returns self modulo a Numeric.
+		//
+		// @return [BigFloat]
+		Name: "%",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Float) *big.Float {
+				quo := new(big.Float).SetPrec(result.Prec())
+				quo.Quo(left, right)
+				i, _ := quo.Int(nil)
+				intQuo := new(big.Float).SetPrec(result.Prec()).SetInt(i)
+				return result.Sub(left, intQuo.Mul(intQuo, right))
+			}
+
+			return receiver.(*BigFloatObject).arithmeticOperation(t, args[0], operation, sourceLine, true)
+		},
+	},
+	{
+		// Raises self to the power of an Integer. A negative exponent yields
+		// the reciprocal of self raised to its absolute value.
+		//
+		// @return [BigFloat]
+		Name: "**",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			b := receiver.(*BigFloatObject)
+
+			expInt, ok := args[0].(*IntegerObject)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			}
+
+			result := bigFloatPow(b.value.Prec(), b.value.Mode(), b.value, int64(expInt.value))
+
+			return t.vm.initBigFloatObject(result)
+		},
+	},
+	{
+		// Compares self to a Numeric, returning -1, 0, or 1.
+		//
+		// @return [Integer]
+		Name: "<=>",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			rightBf, ok := toBigFloat(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return t.vm.InitIntegerObject(receiver.(*BigFloatObject).value.Cmp(rightBf))
+		},
+	},
+	{
+		Name: "<",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			rightBf, ok := toBigFloat(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(receiver.(*BigFloatObject).value.Cmp(rightBf) < 0)
+		},
+	},
+	{
+		Name: "<=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			rightBf, ok := toBigFloat(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(receiver.(*BigFloatObject).value.Cmp(rightBf) <= 0)
+		},
+	},
+	{
+		Name: ">",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			rightBf, ok := toBigFloat(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(receiver.(*BigFloatObject).value.Cmp(rightBf) > 0)
+		},
+	},
+	{
+		Name: ">=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			rightBf, ok := toBigFloat(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(receiver.(*BigFloatObject).value.Cmp(rightBf) >= 0)
+		},
+	},
+	{
+		// Returns the `Float` representation of self, which may lose precision.
+		//
+		// @return [Float]
+		Name: "to_f",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f, _ := receiver.(*BigFloatObject).value.Float64()
+			return t.vm.initFloatObject(f)
+		},
+	},
+	{
+		// Returns the `Integer` representation of self, truncating towards zero.
+		//
+		// @return [Integer]
+		Name: "to_i",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i, _ := receiver.(*BigFloatObject).value.Int64()
+			return t.vm.InitIntegerObject(int(i))
+		},
+	},
+	{
+		// Returns the `Decimal` representation of self.
+		//
+		// @return [Decimal]
+		Name: "to_d",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			s := receiver.(*BigFloatObject).value.Text('f', -1)
+			de, ok := new(Decimal).SetString(s)
+			if ok == false {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, s)
+			}
+
+			return t.vm.initDecimalObject(de)
+		},
+	},
+	{
+		// Returns a `String` representation of self, formatted with the given
+		// `base:` (10 or 16), `format:` (a `big.Float.Text` verb: `'g'`, `'f'`,
+		// `'e'`, `'b'`, `'p'`, `'x'`) and `prec:` (digits after the decimal
+		// point, `-1` for the smallest number of digits necessary).
+		//
+		// ```ruby
+		// BigFloat.new("1.5").to_s             # => "1.5"
+		// BigFloat.new("1.5").to_s(format: "e") # => "1.5e+00"
+		// ```
+		//
+		// @return [String]
+		Name: "to_s",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			format := byte('g')
+			prec := -1
+
+			for _, arg := range args {
+				pair, ok := arg.(*HashObject)
+				if !ok {
+					continue
+				}
+				if f, ok := pair.Pairs["format"]; ok {
+					fs, ok := f.(*StringObject)
+					if !ok || len(fs.value) != 1 {
+						return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect format: to be a single character String")
+					}
+					format = fs.value[0]
+				}
+				if p, ok := pair.Pairs["prec"]; ok {
+					pi, ok := p.(*IntegerObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, p.Class().Name)
+					}
+					prec = pi.value
+				}
+				if b, ok := pair.Pairs["base"]; ok {
+					bi, ok := b.(*IntegerObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, b.Class().Name)
+					}
+					if bi.value == 16 {
+						format = 'x'
+					}
+				}
+			}
+
+			return t.vm.initStringObject(receiver.(*BigFloatObject).value.Text(format, prec))
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initBigFloatObject(value *big.Float) *BigFloatObject {
+	return &BigFloatObject{
+		BaseObj: NewBaseObject(vm.TopLevelClass(classes.BigFloatClass)),
+		value:   value,
+	}
+}
+
+func (vm *VM) initBigFloatClass() *RClass {
+	bc := vm.initializeClass(classes.BigFloatClass)
+	bc.setBuiltinMethods(builtinBigFloatInstanceMethods, false)
+	bc.setBuiltinMethods(builtinBigFloatClassMethods, true)
+	for name := range bigFloatRoundingModes {
+		bc.constants[roundingModeConstantName(name)] = &Pointer{Target: vm.initStringObject(name)}
+	}
+	return bc
+}
+
+// roundingModeConstantName upper-cases a bigFloatRoundingModes key into the
+// Goby constant name it's exposed under, e.g. "to_nearest_even" ->
+// "TO_NEAREST_EVEN".
+func roundingModeConstantName(name string) string {
+	return strings.ToUpper(name)
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (b *BigFloatObject) Value() interface{} {
+	return b.value
+}
+
+// Numeric interface
+func (b *BigFloatObject) floatValue() float64 {
+	f, _ := b.value.Float64()
+	return f
+}
+
+// toBigFloat coerces a Float, Integer, Decimal or BigFloat into a *big.Float,
+// for use by BigFloat's own operators. A BigFloat keeps its own precision; a
+// Float gets 53 bits, an Integer 64, and a Decimal at least 64 (enough for
+// its numerator and denominator). ok is false for a NaN Float.
+func toBigFloat(obj Object) (*big.Float, bool) {
+	switch v := obj.(type) {
+	case *BigFloatObject:
+		return v.value, true
+	case *FloatObject:
+		return bigFloatFromFloat64(0, v.value)
+	case *IntegerObject:
+		return new(big.Float).SetInt64(int64(v.value)), true
+	case *DecimalObject:
+		return new(big.Float).SetRat(v.value), true
+	default:
+		return nil, false
+	}
+}
+
+// bigFloatFromFloat64 guards the construction of a *big.Float from a Go
+// float64: big.Float.SetFloat64 panics on NaN, so construction sites that
+// accept a Float value go through here, except BigFloat.new, which checks
+// for NaN itself before calling SetFloat64 with its own rounding mode. prec == 0 keeps SetFloat64's own default
+// precision (53 bits). ok is false when v is NaN.
+func bigFloatFromFloat64(prec uint, v float64) (*big.Float, bool) {
+	if math.IsNaN(v) {
+		return nil, false
+	}
+
+	bf := new(big.Float)
+	if prec != 0 {
+		bf.SetPrec(prec)
+	}
+
+	return bf.SetFloat64(v), true
+}
+
+// arithmeticOperation applies the passed arithmetic operation, coercing the
+// right-hand side and propagating the maximum precision of the two operands.
+func (b *BigFloatObject) arithmeticOperation(t *Thread, rightObject Object, operation func(result, left, right *big.Float) *big.Float, sourceLine int, division bool) Object {
+	rightValue, ok := toBigFloat(rightObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", rightObject.Class().Name)
+	}
+
+	if division && rightValue.Sign() == 0 {
+		return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+	}
+
+	prec := b.value.Prec()
+	if rightValue.Prec() > prec {
+		prec = rightValue.Prec()
+	}
+
+	result := new(big.Float).SetPrec(prec).SetMode(b.value.Mode())
+	result = operation(result, b.value, rightValue)
+
+	return t.vm.initBigFloatObject(result)
+}
+
+// equalTo returns true if the objects are considered numerically equal.
+func (b *BigFloatObject) equalTo(rightObject Object) bool {
+	rightValue, ok := toBigFloat(rightObject)
+	if !ok {
+		return false
+	}
+
+	return b.value.Cmp(rightValue) == 0
+}
+
+func (b *BigFloatObject) lessThan(arg Object) bool {
+	rightValue, ok := toBigFloat(arg)
+	if !ok {
+		return false
+	}
+
+	return b.value.Cmp(rightValue) < 0
+}
+
+// ToString returns the object's value using the shortest representation
+// that round-trips.
+func (b *BigFloatObject) ToString() string {
+	s := b.value.Text('g', -1)
+	if !strings.Contains(s, ".") && !strings.ContainsAny(s, "eE") {
+		return s + ".0"
+	}
+	return s
+}
+
+// Inspect delegates to ToString
+func (b *BigFloatObject) Inspect() string {
+	return b.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (b *BigFloatObject) ToJSON(t *Thread) string {
+	return b.ToString()
+}
+
+// roundingModeFor maps a Goby RoundingMode symbol name to big.RoundingMode.
+func roundingModeFor(name string) (big.RoundingMode, bool) {
+	mode, ok := bigFloatRoundingModes[name]
+	return mode, ok
+}
+
+// bigFloatPow raises base to the integer power exp by squaring, so the
+// number of big.Float multiplications is O(log exp) rather than O(exp) —
+// a naive repeated-multiplication loop would let something like
+// `BigFloat.new(2) ** 1_000_000_000` hang the VM.
+func bigFloatPow(prec uint, mode big.RoundingMode, base *big.Float, exp int64) *big.Float {
+	result := new(big.Float).SetPrec(prec).SetMode(mode).SetInt64(1)
+	b := new(big.Float).SetPrec(prec).SetMode(mode).Set(base)
+
+	if exp < 0 {
+		b.Quo(new(big.Float).SetPrec(prec).SetMode(mode).SetInt64(1), b)
+		exp = -exp
+	}
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result.Mul(result, b)
+		}
+		b.Mul(b, b)
+		exp >>= 1
+	}
+
+	return result
+}
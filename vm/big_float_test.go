@@ -0,0 +1,43 @@
+package vm
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigFloatFromFloat64(t *testing.T) {
+	if _, ok := bigFloatFromFloat64(53, math.NaN()); ok {
+		t.Fatal("expected bigFloatFromFloat64 to reject NaN instead of panicking")
+	}
+
+	bf, ok := bigFloatFromFloat64(53, 3.5)
+	if !ok {
+		t.Fatal("expected bigFloatFromFloat64(53, 3.5) to succeed")
+	}
+	if f, _ := bf.Float64(); f != 3.5 {
+		t.Fatalf("expected 3.5, got %v", f)
+	}
+}
+
+func TestBigFloatPow(t *testing.T) {
+	tests := []struct {
+		base     float64
+		exp      int64
+		expected float64
+	}{
+		{2, 10, 1024},
+		{2, 0, 1},
+		{2, -1, 0.5},
+		{3, 4, 81},
+	}
+
+	for _, tt := range tests {
+		base := new(big.Float).SetPrec(256).SetFloat64(tt.base)
+		result := bigFloatPow(base.Prec(), base.Mode(), base, tt.exp)
+		got, _ := result.Float64()
+		if got != tt.expected {
+			t.Errorf("bigFloatPow(%v, %v) = %v, want %v", tt.base, tt.exp, got, tt.expected)
+		}
+	}
+}
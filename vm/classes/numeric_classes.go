@@ -0,0 +1,11 @@
+package classes
+
+// Class names for the numeric types and modules added alongside BigFloat,
+// Rational, Complex, and Math. Kept in their own file so they don't collide
+// with the core class name constants declared elsewhere in this package.
+const (
+	BigFloatClass = "BigFloat"
+	RationalClass = "Rational"
+	ComplexClass  = "Complex"
+	MathClass     = "Math"
+)
@@ -0,0 +1,486 @@
+package vm
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// ComplexObject represents a complex number, backed by Go's native
+// `complex128`. Combining a `Complex` with a `Float` or `Integer` promotes
+// the other side to `Complex(n, 0)`, the same way `Float` promotes an
+// `Integer` operand.
+//
+// ```ruby
+// Complex.new(3, 4) + Complex.new(1, 2) # => 4+6i
+// Complex.new(3, 4).abs                 # => 5.0
+// ```
+//
+// - `Complex.new` is the only way to create a `Complex`.
+type ComplexObject struct {
+	*BaseObj
+	value complex128
+}
+
+// Class methods --------------------------------------------------------
+var builtinComplexClassMethods = []*BuiltinMethodObject{
+	{
+		// Creates a `Complex` from a real and an imaginary `Numeric` part.
+		//
+		// ```ruby
+		// Complex.new(3, 4) # => 3+4i
+		// ```
+		//
+		// @return [Complex]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 2 arguments. got=%d", len(args))
+			}
+
+			re, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			im, ok := args[1].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[1].Class().Name)
+			}
+
+			return t.vm.initComplexObject(complex(re.floatValue(), im.floatValue()))
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinComplexInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the sum of self and a Numeric.
+		//
+		// @return [Complex]
+		Name: "+",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(left, right complex128) complex128 {
+				return left + right
+			}
+
+			return receiver.(*ComplexObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns the subtraction of a Numeric from self.
+		//
+		// @return [Complex]
+		Name: "-",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(left, right complex128) complex128 {
+				return left - right
+			}
+
+			return receiver.(*ComplexObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns self multiplied by a Numeric.
+		//
+		// @return [Complex]
+		Name: "*",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(left, right complex128) complex128 {
+				return left * right
+			}
+
+			return receiver.(*ComplexObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns self divided by a Numeric.
+		//
+		// @return [Complex]
+		Name: "/",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(left, right complex128) complex128 {
+				return left / right
+			}
+
+			return receiver.(*ComplexObject).arithmeticOperation(t, args[0], operation, sourceLine, true)
+		},
+	},
+	{
+		// Raises self to the power of a Numeric.
+		//
+		// @return [Complex]
+		Name: "**",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := cmplx.Pow
+			return receiver.(*ComplexObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns true if self and a Numeric are numerically equal.
+		//
+		// @return [Boolean]
+		Name: "==",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return toBooleanObject(receiver.(*ComplexObject).equalTo(args[0]))
+		},
+	},
+	{
+		// Compares self to a Numeric, returning -1, 0, or 1. Raises an error if
+		// either side has a non-zero imaginary part, since complex numbers
+		// aren't ordered.
+		//
+		// @return [Integer]
+		Name: "<=>",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			rightValue, ok := toComplex128(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			leftValue := receiver.(*ComplexObject).value
+
+			if imag(leftValue) != 0 || imag(rightValue) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Can't compare Complex numbers with a non-zero imaginary part")
+			}
+
+			switch {
+			case real(leftValue) < real(rightValue):
+				return t.vm.InitIntegerObject(-1)
+			case real(leftValue) > real(rightValue):
+				return t.vm.InitIntegerObject(1)
+			default:
+				return t.vm.InitIntegerObject(0)
+			}
+		},
+	},
+	{
+		// Returns the real part of self.
+		//
+		// @return [Float]
+		Name: "real",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initFloatObject(real(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the imaginary part of self.
+		//
+		// @return [Float]
+		Name: "imag",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initFloatObject(imag(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the complex conjugate of self.
+		//
+		// ```ruby
+		// Complex.new(3, 4).conjugate # => 3-4i
+		// ```
+		//
+		// @return [Complex]
+		Name: "conjugate",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Conj(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the magnitude (absolute value) of self.
+		//
+		// @return [Float]
+		Name: "abs",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initFloatObject(cmplx.Abs(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the phase angle of self, in radians.
+		//
+		// @return [Float]
+		Name: "arg",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initFloatObject(cmplx.Phase(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Alias for `arg`: the phase angle of self, in radians.
+		//
+		// @return [Float]
+		Name: "angle",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initFloatObject(cmplx.Phase(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the polar representation of self as a two-element Array
+		// `[magnitude, phase]`.
+		//
+		// @return [Array]
+		Name: "polar",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r, theta := cmplx.Polar(receiver.(*ComplexObject).value)
+			return t.vm.initArrayObject([]Object{t.vm.initFloatObject(r), t.vm.initFloatObject(theta)})
+		},
+	},
+	{
+		// Returns the rectangular representation of self as a two-element
+		// Array `[real, imaginary]`.
+		//
+		// @return [Array]
+		Name: "rectangular",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			v := receiver.(*ComplexObject).value
+			return t.vm.initArrayObject([]Object{t.vm.initFloatObject(real(v)), t.vm.initFloatObject(imag(v))})
+		},
+	},
+	{
+		// Returns e raised to the power of self.
+		//
+		// ```ruby
+		// Complex.new(0, 0).exp # => 1+0i
+		// ```
+		//
+		// @return [Complex]
+		Name: "exp",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Exp(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the natural logarithm of self.
+		//
+		// ```ruby
+		// Complex.new(1, 0).log # => 0+0i
+		// ```
+		//
+		// @return [Complex]
+		Name: "log",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Log(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the principal square root of self.
+		//
+		// ```ruby
+		// Complex.new(-1, 0).sqrt # => 0+1i
+		// ```
+		//
+		// @return [Complex]
+		Name: "sqrt",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Sqrt(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the sine of self.
+		//
+		// @return [Complex]
+		Name: "sin",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Sin(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the cosine of self.
+		//
+		// @return [Complex]
+		Name: "cos",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Cos(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the tangent of self.
+		//
+		// @return [Complex]
+		Name: "tan",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Tan(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the hyperbolic sine of self.
+		//
+		// @return [Complex]
+		Name: "sinh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Sinh(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the hyperbolic cosine of self.
+		//
+		// @return [Complex]
+		Name: "cosh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Cosh(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the hyperbolic tangent of self.
+		//
+		// @return [Complex]
+		Name: "tanh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Tanh(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the inverse sine of self.
+		//
+		// @return [Complex]
+		Name: "asin",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Asin(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the inverse cosine of self.
+		//
+		// @return [Complex]
+		Name: "acos",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Acos(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the inverse tangent of self.
+		//
+		// @return [Complex]
+		Name: "atan",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Atan(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the inverse hyperbolic sine of self.
+		//
+		// @return [Complex]
+		Name: "asinh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Asinh(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the inverse hyperbolic cosine of self.
+		//
+		// @return [Complex]
+		Name: "acosh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Acosh(receiver.(*ComplexObject).value))
+		},
+	},
+	{
+		// Returns the inverse hyperbolic tangent of self.
+		//
+		// @return [Complex]
+		Name: "atanh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.initComplexObject(cmplx.Atanh(receiver.(*ComplexObject).value))
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initComplexObject(value complex128) *ComplexObject {
+	return &ComplexObject{
+		BaseObj: NewBaseObject(vm.TopLevelClass(classes.ComplexClass)),
+		value:   value,
+	}
+}
+
+func (vm *VM) initComplexClass() *RClass {
+	cc := vm.initializeClass(classes.ComplexClass)
+	cc.setBuiltinMethods(builtinComplexInstanceMethods, false)
+	cc.setBuiltinMethods(builtinComplexClassMethods, true)
+	cc.constants["I"] = &Pointer{Target: vm.initComplexObject(complex(0, 1))}
+	return cc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (c *ComplexObject) Value() interface{} {
+	return c.value
+}
+
+// Numeric interface
+func (c *ComplexObject) floatValue() float64 {
+	return real(c.value)
+}
+
+// toComplex128 coerces a Complex, or any other Numeric (Integer, Float,
+// Rational, BigFloat), into a complex128. A non-Complex Numeric is promoted
+// to Complex(n, 0).
+func toComplex128(obj Object) (complex128, bool) {
+	switch v := obj.(type) {
+	case *ComplexObject:
+		return v.value, true
+	default:
+		if n, ok := obj.(Numeric); ok {
+			return complex(n.floatValue(), 0), true
+		}
+		return 0, false
+	}
+}
+
+// arithmeticOperation applies the passed arithmetic operation, promoting the
+// right-hand side to Complex(n, 0) if it's a Float, Integer, or other
+// Numeric.
+func (c *ComplexObject) arithmeticOperation(t *Thread, rightObject Object, operation func(left, right complex128) complex128, sourceLine int, division bool) Object {
+	rightValue, ok := toComplex128(rightObject)
+	if !ok {
+		return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", rightObject.Class().Name)
+	}
+
+	if division && rightValue == 0 {
+		return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+	}
+
+	result := operation(c.value, rightValue)
+
+	return t.vm.initComplexObject(result)
+}
+
+// equalTo returns true if the objects are considered numerically equal.
+func (c *ComplexObject) equalTo(rightObject Object) bool {
+	rightValue, ok := toComplex128(rightObject)
+	if !ok {
+		return false
+	}
+
+	return c.value == rightValue
+}
+
+// ToString renders self as "re+imi" or "re-imi", matching Ruby's Complex#to_s.
+func (c *ComplexObject) ToString() string {
+	re := real(c.value)
+	im := imag(c.value)
+
+	sign := "+"
+	if im < 0 || math.Signbit(im) {
+		sign = "-"
+		im = -im
+	}
+
+	return strconv.FormatFloat(re, 'g', -1, 64) + sign + strconv.FormatFloat(im, 'g', -1, 64) + "i"
+}
+
+// Inspect delegates to ToString
+func (c *ComplexObject) Inspect() string {
+	return c.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (c *ComplexObject) ToJSON(t *Thread) string {
+	return c.ToString()
+}
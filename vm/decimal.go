@@ -0,0 +1,31 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// Numeric interop -------------------------------------------------------
+var builtinDecimalInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the exact `Rational` representation of self.
+		//
+		// ```Ruby
+		// "3.14".to_d.to_r # => 157/50
+		// ```
+		//
+		// @return [Rational]
+		Name: "to_r",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			s := receiver.(*DecimalObject).value.String()
+
+			r, ok := new(big.Rat).SetString(s)
+			if !ok {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, s)
+			}
+
+			return t.vm.initRationalObject(r)
+		},
+	},
+}
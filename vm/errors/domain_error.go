@@ -0,0 +1,6 @@
+package errors
+
+// DomainError is raised by Math's functions (`sqrt`, `log`, `asin`, etc.)
+// when called with an argument outside the function's domain, e.g.
+// `Math.sqrt(-1)`.
+const DomainError = "DomainError"
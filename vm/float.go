@@ -2,6 +2,8 @@ package vm
 
 import (
 	"math"
+	"math/big"
+	"math/cmplx"
 	"strings"
 
 	"strconv"
@@ -47,11 +49,22 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// @return [Float]
 		Name: "+",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f := receiver.(*FloatObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left + right }
+				return f.floatComplexOp(t, args[0], op, sourceLine, false)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Add(left, right) }
+				return f.floatBigFloatOp(t, args[0], op, sourceLine, false)
+			}
+
 			operation := func(leftValue float64, rightValue float64) float64 {
 				return leftValue + rightValue
 			}
 
-			return receiver.(*FloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+			return f.arithmeticOperation(t, args[0], operation, sourceLine, false)
 
 		},
 	},
@@ -65,8 +78,24 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// @return [Float]
 		Name: "%",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f := receiver.(*FloatObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float {
+					quo := new(big.Float).SetPrec(result.Prec())
+					quo.Quo(left, right)
+					i, _ := quo.Int(nil)
+					intQuo := new(big.Float).SetPrec(result.Prec()).SetInt(i)
+					return result.Sub(left, intQuo.Mul(intQuo, right))
+				}
+				return f.floatBigFloatOp(t, args[0], op, sourceLine, true)
+			}
+
 			operation := math.Mod
-			return receiver.(*FloatObject).arithmeticOperation(t, args[0], operation, sourceLine, true)
+			return f.arithmeticOperation(t, args[0], operation, sourceLine, true)
 
 		},
 	},
@@ -80,11 +109,22 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// @return [Float]
 		Name: "-",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f := receiver.(*FloatObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left - right }
+				return f.floatComplexOp(t, args[0], op, sourceLine, false)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Sub(left, right) }
+				return f.floatBigFloatOp(t, args[0], op, sourceLine, false)
+			}
+
 			operation := func(leftValue float64, rightValue float64) float64 {
 				return leftValue - rightValue
 			}
 
-			return receiver.(*FloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+			return f.arithmeticOperation(t, args[0], operation, sourceLine, false)
 
 		},
 	},
@@ -98,11 +138,22 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// @return [Float]
 		Name: "*",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f := receiver.(*FloatObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left * right }
+				return f.floatComplexOp(t, args[0], op, sourceLine, false)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Mul(left, right) }
+				return f.floatBigFloatOp(t, args[0], op, sourceLine, false)
+			}
+
 			operation := func(leftValue float64, rightValue float64) float64 {
 				return leftValue * rightValue
 			}
 
-			return receiver.(*FloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+			return f.arithmeticOperation(t, args[0], operation, sourceLine, false)
 
 		},
 	},
@@ -116,8 +167,25 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// @return [Float]
 		Name: "**",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f := receiver.(*FloatObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				return f.floatComplexOp(t, args[0], cmplx.Pow, sourceLine, false)
+			}
+			if bfArg, ok := args[0].(*BigFloatObject); ok {
+				expInt, acc := bfArg.value.Int64()
+				if acc != big.Exact {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, bfArg.Class().Name)
+				}
+				base, ok := bigFloatFromFloat64(t.vm.defaultBigFloatPrecision(), f.value)
+				if !ok {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, strconv.FormatFloat(f.value, 'g', -1, 64))
+				}
+				return t.vm.initBigFloatObject(bigFloatPow(base.Prec(), base.Mode(), base, expInt))
+			}
+
 			operation := math.Pow
-			return receiver.(*FloatObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+			return f.arithmeticOperation(t, args[0], operation, sourceLine, false)
 
 		},
 	},
@@ -131,11 +199,22 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// @return [Float]
 		Name: "/",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f := receiver.(*FloatObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left / right }
+				return f.floatComplexOp(t, args[0], op, sourceLine, true)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Quo(left, right) }
+				return f.floatBigFloatOp(t, args[0], op, sourceLine, true)
+			}
+
 			operation := func(leftValue float64, rightValue float64) float64 {
 				return leftValue / rightValue
 			}
 
-			return receiver.(*FloatObject).arithmeticOperation(t, args[0], operation, sourceLine, true)
+			return f.arithmeticOperation(t, args[0], operation, sourceLine, true)
 
 		},
 	},
@@ -256,6 +335,10 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 			leftValue := receiver.(*FloatObject).value
 			rightValue := rightNumeric.floatValue()
 
+			if math.IsNaN(leftValue) || math.IsNaN(rightValue) {
+				return NULL
+			}
+
 			if leftValue < rightValue {
 				return t.vm.InitIntegerObject(-1)
 			}
@@ -267,6 +350,59 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Returns a String representation of self. Accepts keyword arguments
+		// `format:` (one of `strconv.FormatFloat`'s verbs: `'e'`, `'f'`, `'g'`,
+		// `'b'`, `'x'`; defaults to `'f'`), `precision:` (digits after the
+		// decimal point, `-1` for the shortest round-trip representation),
+		// and `base:` (`10` or `16`, where `16` selects hex-float notation).
+		//
+		// ```Ruby
+		// 1234.5678.to_s                  # => "1234.5678"
+		// 1234.5678.to_s(format: "e")      # => "1.2345678e+03"
+		// 1234.5678.to_s(precision: 2)     # => "1234.57"
+		// 1.5.to_s(base: 16)               # => "0x1.8p+00"
+		// ```
+		//
+		// @return [String]
+		Name: "to_s",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			format := byte('f')
+			prec := -1
+
+			for _, arg := range args {
+				pair, ok := arg.(*HashObject)
+				if !ok {
+					continue
+				}
+				if fo, ok := pair.Pairs["format"]; ok {
+					fs, ok := fo.(*StringObject)
+					if !ok || len(fs.value) != 1 {
+						return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect format: to be a single character String")
+					}
+					format = fs.value[0]
+				}
+				if p, ok := pair.Pairs["precision"]; ok {
+					pi, ok := p.(*IntegerObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, p.Class().Name)
+					}
+					prec = pi.value
+				}
+				if b, ok := pair.Pairs["base"]; ok {
+					bi, ok := b.(*IntegerObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, b.Class().Name)
+					}
+					if bi.value == 16 {
+						format = 'x'
+					}
+				}
+			}
+
+			return t.vm.initStringObject(formatFloat(receiver.(*FloatObject).value, format, prec))
+		},
+	},
 	{
 		// Converts the Integer object into Decimal object and returns it.
 		// Each digit of the float is literally transferred to the corresponding digit
@@ -312,6 +448,59 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 
 		},
 	},
+	{
+		// Converts the Float object into a BigFloat, using the default
+		// BigFloat precision.
+		//
+		// ```Ruby
+		// 3.14.to_big # => 3.140000000000000124344978758017532527446746826171875
+		// ```
+		//
+		// @return [BigFloat]
+		Name: "to_big",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*FloatObject).value
+			bf, ok := bigFloatFromFloat64(t.vm.defaultBigFloatPrecision(), r)
+			if !ok {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, strconv.FormatFloat(r, 'g', -1, 64))
+			}
+			return t.vm.initBigFloatObject(bf)
+		},
+	},
+	{
+		// Converts the Float object into a Rational object, exactly — the
+		// IEEE-754 double is reinterpreted as a fraction, not rounded to a
+		// "nice" decimal.
+		//
+		// ```Ruby
+		// 0.5.to_r # => 1/2
+		// ```
+		//
+		// @return [Rational]
+		Name: "to_r",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*FloatObject).value
+			rat := new(big.Rat).SetFloat64(r)
+			if rat == nil {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, strconv.FormatFloat(r, 'g', -1, 64))
+			}
+			return t.vm.initRationalObject(rat)
+		},
+	},
+	{
+		// Converts the Float object into a Complex with an imaginary part of 0.
+		//
+		// ```Ruby
+		// 3.0.to_c # => 3+0i
+		// ```
+		//
+		// @return [Complex]
+		Name: "to_c",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*FloatObject).value
+			return t.vm.initComplexObject(complex(r, 0))
+		},
+	},
 	{
 		Name: "ptr",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
@@ -338,49 +527,79 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		// Returns the smallest Integer greater than or equal to self.
+		// Returns the smallest number greater than or equal to self, rounded
+		// to `n` decimal digits. A positive `n` keeps `n` digits and returns a
+		// `Float`; `n` <= 0 (the default) rounds to `10**-n` and returns an
+		// `Integer`.
 		//
 		// ```Ruby
-		// 1.2.ceil  # => 2
-		// 2.ceil    # => 2
-		// -1.2.ceil # => -1
-		// -2.ceil   # => -2
+		// 1.2.ceil     # => 2
+		// 2.ceil       # => 2
+		// -1.2.ceil    # => -1
+		// -2.ceil      # => -2
+		// 1.234.ceil(2) # => 1.24
+		// 1234.ceil(-2) # => 1300
 		// ```
-		// @return [Integer]
+		// @return [Integer, Float]
 		Name: "ceil",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			// TODO: Make ceil accept arguments
-			if len(args) != 0 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			n, err := floatPrecisionArg(t, sourceLine, args)
+			if err != nil {
+				return err
 			}
+
 			r := receiver.(*FloatObject)
-			result := math.Ceil(r.value)
-			newInt := t.vm.InitIntegerObject(int(result))
-			newInt.flag = i
-			return newInt
+			return roundWithPrecision(t, r.value, n, math.Ceil)
 		},
 	},
 	{
-		// Returns the largest Integer less than or equal to self.
+		// Returns the largest number less than or equal to self, rounded to
+		// `n` decimal digits. A positive `n` keeps `n` digits and returns a
+		// `Float`; `n` <= 0 (the default) rounds to `10**-n` and returns an
+		// `Integer`.
 		//
 		// ```Ruby
-		// 1.2.floor  # => 1
-		// 2.0.floor  # => 2
-		// -1.2.floor # => -2
-		// -2.0.floor # => -2
+		// 1.2.floor     # => 1
+		// 2.0.floor     # => 2
+		// -1.2.floor    # => -2
+		// -2.0.floor    # => -2
+		// 1.234.floor(2) # => 1.23
+		// 1234.floor(-2) # => 1200
 		// ```
-		// @return [Integer]
+		// @return [Integer, Float]
 		Name: "floor",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			// TODO: Make floor accept arguments
-			if len(args) != 0 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			n, err := floatPrecisionArg(t, sourceLine, args)
+			if err != nil {
+				return err
 			}
+
 			r := receiver.(*FloatObject)
-			result := math.Floor(r.value)
-			newInt := t.vm.InitIntegerObject(int(result))
-			newInt.flag = i
-			return newInt
+			return roundWithPrecision(t, r.value, n, math.Floor)
+		},
+	},
+	{
+		// Returns self with its fractional part discarded, rounded to `n`
+		// decimal digits. A positive `n` keeps `n` digits and returns a
+		// `Float`; `n` <= 0 (the default) rounds to `10**-n` and returns an
+		// `Integer`.
+		//
+		// ```Ruby
+		// 1.7.truncate     # => 1
+		// -1.7.truncate    # => -1
+		// 1.2345.truncate(2) # => 1.23
+		// 1234.truncate(-2)  # => 1200
+		// ```
+		// @return [Integer, Float]
+		Name: "truncate",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			n, err := floatPrecisionArg(t, sourceLine, args)
+			if err != nil {
+				return err
+			}
+
+			r := receiver.(*FloatObject)
+			return roundWithPrecision(t, r.value, n, math.Trunc)
 		},
 	},
 	{
@@ -437,7 +656,117 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		},
 	},
 	{
-		//  Rounds float to a given precision in decimal digits (default 0 digits)
+		// Returns true if self is `NaN` (not a number).
+		//
+		// ```Ruby
+		// (0.0/0.0).nan? # => true
+		// 1.0.nan?       # => false
+		// ```
+		// @return [Boolean]
+		Name: "nan?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			}
+			r := receiver.(*FloatObject)
+			return toBooleanObject(math.IsNaN(r.value))
+		},
+	},
+	{
+		// Returns `1` if self is positive infinity, `-1` if negative infinity,
+		// and `nil` otherwise.
+		//
+		// ```Ruby
+		// (1.0/0.0).infinite?  # => 1
+		// (-1.0/0.0).infinite? # => -1
+		// 1.0.infinite?        # => nil
+		// ```
+		// @return [Integer, nil]
+		Name: "infinite?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			}
+			r := receiver.(*FloatObject)
+			if math.IsInf(r.value, 1) {
+				return t.vm.InitIntegerObject(1)
+			}
+			if math.IsInf(r.value, -1) {
+				return t.vm.InitIntegerObject(-1)
+			}
+			return NULL
+		},
+	},
+	{
+		// Returns true if self is neither infinite nor `NaN`.
+		//
+		// ```Ruby
+		// 1.0.finite?        # => true
+		// (1.0/0.0).finite?  # => false
+		// (0.0/0.0).finite?  # => false
+		// ```
+		// @return [Boolean]
+		Name: "finite?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			}
+			r := receiver.(*FloatObject)
+			return toBooleanObject(!math.IsInf(r.value, 0) && !math.IsNaN(r.value))
+		},
+	},
+	{
+		// Returns `-1`, `0`, or `1` depending on the sign of self. `NaN` has a
+		// sign of `0`.
+		//
+		// ```Ruby
+		// (-3.5).sign # => -1
+		// 0.0.sign    # => 0
+		// 3.5.sign    # => 1
+		// ```
+		// @return [Integer]
+		Name: "sign",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			}
+			r := receiver.(*FloatObject).value
+			if math.IsNaN(r) || r == 0 {
+				return t.vm.InitIntegerObject(0)
+			}
+			if r < 0 {
+				return t.vm.InitIntegerObject(-1)
+			}
+			return t.vm.InitIntegerObject(1)
+		},
+	},
+	{
+		// Returns self if self is not zero, `nil` otherwise.
+		//
+		// ```Ruby
+		// 1.0.nonzero? # => 1.0
+		// 0.0.nonzero? # => nil
+		// ```
+		// @return [Float, nil]
+		Name: "nonzero?",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 0 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 argument. got=%v", strconv.Itoa(len(args)))
+			}
+			r := receiver.(*FloatObject)
+			if r.value == 0.0 {
+				return NULL
+			}
+			return r
+		},
+	},
+	{
+		//  Rounds float to a given precision in decimal digits (default 0
+		// digits). The tie-breaking rule for halfway values is controlled by
+		// the `half:` keyword argument: `:up` (the default) rounds away from
+		// zero, `:down` rounds towards zero, `:even` uses banker's rounding
+		// (ties go to the nearest even digit), and `:truncate` discards the
+		// fractional part outright, ignoring ties altogether.
 		//
 		// ```Ruby
 		// 1.115.round  # => 1
@@ -446,28 +775,65 @@ var builtinFloatInstanceMethods = []*BuiltinMethodObject{
 		// -1.115.round  # => -1
 		// -1.115.round(1)  # => -1.1
 		// -1.115.round(2)  # => -1.12
+		// 2.5.round(half: :even) # => 2.0
+		// 2.5.round(half: :down) # => 2.0
 		// ```
-		// @return [Integer]
+		// @return [Float]
 		Name: "round",
 		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
-			var precision int
-
-			if len(args) > 1 {
-				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 or 1 argument. got=%v", strconv.Itoa(len(args)))
-			} else if len(args) == 1 {
-				int, ok := args[0].(*IntegerObject)
+			if len(args) > 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 to 2 arguments. got=%v", strconv.Itoa(len(args)))
+			}
 
-				if !ok {
-					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+			precision := 0
+			half := "up"
+
+			for _, arg := range args {
+				switch v := arg.(type) {
+				case *IntegerObject:
+					precision = v.value
+				case *HashObject:
+					h, ok := v.Pairs["half"]
+					if !ok {
+						continue
+					}
+					hs, ok := h.(*StringObject)
+					if !ok {
+						return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, h.Class().Name)
+					}
+					switch hs.value {
+					case "up", "down", "even", "truncate":
+						half = hs.value
+					default:
+						return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, `Invalid half: %s. Expect "up", "down", "even", or "truncate"`, hs.value)
+					}
+				default:
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Integer or Hash", arg.Class().Name)
 				}
-
-				precision = int.value
 			}
 
 			f := receiver.(*FloatObject).floatValue()
 			n := math.Pow10(precision)
+			scaled := f * n
+
+			var rounded float64
+			switch half {
+			case "even":
+				rounded = math.RoundToEven(scaled)
+			case "truncate":
+				rounded = math.Trunc(scaled)
+			case "down":
+				truncated := math.Trunc(scaled)
+				if isExactHalf(f, precision) {
+					rounded = truncated
+				} else {
+					rounded = math.Round(scaled)
+				}
+			default: // "up"
+				rounded = math.Round(scaled)
+			}
 
-			return t.vm.initFloatObject(math.Round(f*n) / n)
+			return t.vm.initFloatObject(rounded / n)
 		},
 	},
 }
@@ -487,6 +853,19 @@ func (vm *VM) initFloatClass() *RClass {
 	ic := vm.initializeClass(classes.FloatClass)
 	ic.setBuiltinMethods(builtinFloatInstanceMethods, false)
 	ic.setBuiltinMethods(builtinFloatClassMethods, true)
+	ic.constants["INFINITY"] = &Pointer{Target: vm.initFloatObject(math.Inf(1))}
+	ic.constants["NAN"] = &Pointer{Target: vm.initFloatObject(math.NaN())}
+	ic.constants["MAX"] = &Pointer{Target: vm.initFloatObject(math.MaxFloat64)}
+	ic.constants["MIN"] = &Pointer{Target: vm.initFloatObject(math.SmallestNonzeroFloat64)}
+	ic.constants["EPSILON"] = &Pointer{Target: vm.initFloatObject(2.220446049250313e-16)}
+	ic.constants["DIG"] = &Pointer{Target: vm.InitIntegerObject(15)}
+	ic.constants["MANT_DIG"] = &Pointer{Target: vm.InitIntegerObject(53)}
+
+	// Float is always bootstrapped, so this is also where BigFloat, Rational,
+	// Complex, and Math get wired in as top-level constants — otherwise
+	// they'd never become reachable from Goby source.
+	vm.initNumericExtensionClasses()
+
 	return ic
 }
 
@@ -523,6 +902,25 @@ func (f *FloatObject) arithmeticOperation(t *Thread, rightObject Object, operati
 	return t.vm.initFloatObject(result)
 }
 
+// floatComplexOp promotes the Float receiver to Complex(f, 0) and applies a
+// Complex arithmetic operation, so a Complex right-hand side combines by its
+// full value instead of being demoted to its real part via Numeric.floatValue.
+func (f *FloatObject) floatComplexOp(t *Thread, rightObject Object, operation func(left, right complex128) complex128, sourceLine int, division bool) Object {
+	return t.vm.initComplexObject(complex(f.value, 0)).arithmeticOperation(t, rightObject, operation, sourceLine, division)
+}
+
+// floatBigFloatOp promotes the Float receiver to a BigFloat at
+// BigFloat.default_precision and applies a BigFloat arithmetic operation, so
+// a BigFloat right-hand side keeps its precision instead of being truncated
+// to float64 via Numeric.floatValue.
+func (f *FloatObject) floatBigFloatOp(t *Thread, rightObject Object, operation func(result, left, right *big.Float) *big.Float, sourceLine int, division bool) Object {
+	bf, ok := bigFloatFromFloat64(t.vm.defaultBigFloatPrecision(), f.value)
+	if !ok {
+		return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, strconv.FormatFloat(f.value, 'g', -1, 64))
+	}
+	return t.vm.initBigFloatObject(bf).arithmeticOperation(t, rightObject, operation, sourceLine, division)
+}
+
 // Apply an equality test, returning true if the objects are considered equal,
 // and false otherwise.
 func (f *FloatObject) equalTo(rightObject Object) bool {
@@ -554,15 +952,82 @@ func (f *FloatObject) lessThan(arg Object) bool {
 	return f.numericComparison(arg, floatComparison)
 }
 
+// formatFloat renders val using the given strconv.FormatFloat verb
+// (`'e'`, `'f'`, `'g'`, `'b'`, or `'x'`) and precision (`-1` for the
+// shortest representation that round-trips). A bare integer produced by
+// the default `'f'`/`-1` combination gets a ".0" suffix, to keep Float
+// string output visually distinct from Integer.
+func formatFloat(val float64, format byte, prec int) string {
+	s := strconv.FormatFloat(val, format, prec, 64)
+	if format == 'f' && prec == -1 && !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// floatPrecisionArg reads the optional integer precision argument shared by
+// `ceil`, `floor`, and `truncate`, defaulting to 0. It returns a non-nil
+// Object only on error.
+func floatPrecisionArg(t *Thread, sourceLine int, args []Object) (int, Object) {
+	if len(args) > 1 {
+		return 0, t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 0 or 1 argument. got=%v", strconv.Itoa(len(args)))
+	}
+	if len(args) == 0 {
+		return 0, nil
+	}
+
+	i, ok := args[0].(*IntegerObject)
+	if !ok {
+		return 0, t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+	}
+
+	return i.value, nil
+}
+
+// isExactHalf reports whether val, kept to precision decimal digits, sits
+// exactly on a tie — i.e. the digit right after the kept precision is a
+// lone, terminal "5". It reads the digit off val's shortest round-tripping
+// decimal string instead of comparing the scaled float against 0.5, since
+// multiplying by 10**precision can itself introduce rounding error that a
+// fixed tolerance would either hide or misfire on.
+func isExactHalf(val float64, precision int) bool {
+	s := strconv.FormatFloat(math.Abs(val), 'f', -1, 64)
+	intPart := s
+	fracPart := ""
+	if dot := strings.IndexByte(s, '.'); dot != -1 {
+		intPart = s[:dot]
+		fracPart = s[dot+1:]
+	}
+
+	digits := intPart + fracPart
+	pos := len(intPart) + precision
+	if pos < 0 || pos >= len(digits) {
+		return false
+	}
+
+	return digits[pos] == '5' && strings.Trim(digits[pos+1:], "0") == ""
+}
+
+// roundWithPrecision scales val by 10**n, applies roundFn, and reshifts back.
+// A positive n (digits kept) returns a Float; n <= 0 (rounding to 10**-n)
+// returns an Integer, matching Ruby's Float#ceil/#floor/#truncate.
+func roundWithPrecision(t *Thread, val float64, n int, roundFn func(float64) float64) Object {
+	scale := math.Pow10(n)
+	result := roundFn(val*scale) / scale
+
+	if n > 0 {
+		return t.vm.initFloatObject(result)
+	}
+
+	newInt := t.vm.InitIntegerObject(int(result))
+	newInt.flag = i
+	return newInt
+}
+
 // ToString returns the object's value as the string format, in non
 // exponential format (straight number, without exponent `E<exp>`).
 func (f *FloatObject) ToString() string {
-	s := strconv.FormatFloat(f.value, 'f', -1, 64)
-	// Add ".0" to represent a float number
-	if !strings.Contains(s, ".") {
-		return s + ".0"
-	}
-	return s
+	return formatFloat(f.value, 'f', -1)
 }
 
 // Inspect delegates to ToString
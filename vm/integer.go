@@ -0,0 +1,254 @@
+package vm
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// Numeric interop -------------------------------------------------------
+//
+// IntegerObject's arithmetic operators promote the other side to BigFloat or
+// Complex when that's what it is, the same way FloatObject's do in
+// float.go — otherwise `5 + BigFloat.new(2)` or `5 + Complex.new(1, 2)`
+// would fall through to the generic Numeric path and truncate the operand
+// to a float64.
+var builtinIntegerInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the sum of self and a Numeric.
+		//
+		// @return [Integer, Float, BigFloat, Complex]
+		Name: "+",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left + right }
+				return i.integerComplexOp(t, args[0], op, sourceLine, false)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Add(left, right) }
+				return i.integerBigFloatOp(t, args[0], op, sourceLine, false)
+			}
+			if right, ok := args[0].(*IntegerObject); ok {
+				return t.vm.InitIntegerObject(i.value + right.value)
+			}
+
+			rightNumeric, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(float64(i.value) + rightNumeric.floatValue())
+		},
+	},
+	{
+		// Returns the subtraction of a Numeric from self.
+		//
+		// @return [Integer, Float, BigFloat, Complex]
+		Name: "-",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left - right }
+				return i.integerComplexOp(t, args[0], op, sourceLine, false)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Sub(left, right) }
+				return i.integerBigFloatOp(t, args[0], op, sourceLine, false)
+			}
+			if right, ok := args[0].(*IntegerObject); ok {
+				return t.vm.InitIntegerObject(i.value - right.value)
+			}
+
+			rightNumeric, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(float64(i.value) - rightNumeric.floatValue())
+		},
+	},
+	{
+		// Returns self multiplied by a Numeric.
+		//
+		// @return [Integer, Float, BigFloat, Complex]
+		Name: "*",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left * right }
+				return i.integerComplexOp(t, args[0], op, sourceLine, false)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Mul(left, right) }
+				return i.integerBigFloatOp(t, args[0], op, sourceLine, false)
+			}
+			if right, ok := args[0].(*IntegerObject); ok {
+				return t.vm.InitIntegerObject(i.value * right.value)
+			}
+
+			rightNumeric, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(float64(i.value) * rightNumeric.floatValue())
+		},
+	},
+	{
+		// Returns self divided by a Numeric.
+		//
+		// @return [Integer, Float, BigFloat, Complex]
+		Name: "/",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				op := func(left, right complex128) complex128 { return left / right }
+				return i.integerComplexOp(t, args[0], op, sourceLine, true)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float { return result.Quo(left, right) }
+				return i.integerBigFloatOp(t, args[0], op, sourceLine, true)
+			}
+			if right, ok := args[0].(*IntegerObject); ok {
+				if right.value == 0 {
+					return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+				}
+				return t.vm.InitIntegerObject(i.value / right.value)
+			}
+
+			rightNumeric, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			rightValue := rightNumeric.floatValue()
+			if rightValue == 0 {
+				return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+			}
+			return t.vm.initFloatObject(float64(i.value) / rightValue)
+		},
+	},
+	{
+		// Returns self modulo a Numeric.
+		//
+		// @return [Integer, Float, BigFloat]
+		Name: "%",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if _, ok := args[0].(*BigFloatObject); ok {
+				op := func(result, left, right *big.Float) *big.Float {
+					quo := new(big.Float).SetPrec(result.Prec())
+					quo.Quo(left, right)
+					q, _ := quo.Int(nil)
+					intQuo := new(big.Float).SetPrec(result.Prec()).SetInt(q)
+					return result.Sub(left, intQuo.Mul(intQuo, right))
+				}
+				return i.integerBigFloatOp(t, args[0], op, sourceLine, true)
+			}
+			if right, ok := args[0].(*IntegerObject); ok {
+				if right.value == 0 {
+					return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+				}
+				return t.vm.InitIntegerObject(i.value % right.value)
+			}
+
+			rightNumeric, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			rightValue := rightNumeric.floatValue()
+			if rightValue == 0 {
+				return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+			}
+			return t.vm.initFloatObject(math.Mod(float64(i.value), rightValue))
+		},
+	},
+	{
+		// Raises self to the power of a Numeric.
+		//
+		// @return [Integer, Float, BigFloat, Complex]
+		Name: "**",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+
+			if _, ok := args[0].(*ComplexObject); ok {
+				return i.integerComplexOp(t, args[0], cmplx.Pow, sourceLine, false)
+			}
+			if bfArg, ok := args[0].(*BigFloatObject); ok {
+				expInt, acc := bfArg.value.Int64()
+				if acc != big.Exact {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Integer", bfArg.Class().Name)
+				}
+				base := new(big.Float).SetPrec(t.vm.defaultBigFloatPrecision()).SetInt64(int64(i.value))
+				return t.vm.initBigFloatObject(bigFloatPow(base.Prec(), base.Mode(), base, expInt))
+			}
+			if right, ok := args[0].(*IntegerObject); ok && right.value >= 0 {
+				result := 1
+				for n := 0; n < right.value; n++ {
+					result *= i.value
+				}
+				return t.vm.InitIntegerObject(result)
+			}
+
+			rightNumeric, ok := args[0].(Numeric)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Pow(float64(i.value), rightNumeric.floatValue()))
+		},
+	},
+	{
+		// Returns the `Rational` representation of self.
+		//
+		// ```Ruby
+		// 3.to_r # => 3/1
+		// ```
+		//
+		// @return [Rational]
+		Name: "to_r",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+			return t.vm.initRationalObject(new(big.Rat).SetInt64(int64(i.value)))
+		},
+	},
+	{
+		// Returns self as a `Complex` with an imaginary part of 0.
+		//
+		// ```Ruby
+		// 3.to_c # => 3+0i
+		// ```
+		//
+		// @return [Complex]
+		Name: "to_c",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			i := receiver.(*IntegerObject)
+			return t.vm.initComplexObject(complex(float64(i.value), 0))
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// integerBigFloatOp promotes the Integer receiver to a BigFloat at
+// BigFloat.default_precision and applies a BigFloat arithmetic operation, so
+// a BigFloat right-hand side keeps its precision instead of being truncated
+// to float64 via Numeric.floatValue.
+func (i *IntegerObject) integerBigFloatOp(t *Thread, rightObject Object, operation func(result, left, right *big.Float) *big.Float, sourceLine int, division bool) Object {
+	bf := new(big.Float).SetPrec(t.vm.defaultBigFloatPrecision()).SetInt64(int64(i.value))
+	return t.vm.initBigFloatObject(bf).arithmeticOperation(t, rightObject, operation, sourceLine, division)
+}
+
+// integerComplexOp promotes the Integer receiver to Complex(i, 0) and
+// applies a Complex arithmetic operation, so a Complex right-hand side
+// combines by its full value instead of being demoted to its real part via
+// Numeric.floatValue.
+func (i *IntegerObject) integerComplexOp(t *Thread, rightObject Object, operation func(left, right complex128) complex128, sourceLine int, division bool) Object {
+	return t.vm.initComplexObject(complex(float64(i.value), 0)).arithmeticOperation(t, rightObject, operation, sourceLine, division)
+}
@@ -0,0 +1,382 @@
+package vm
+
+import (
+	"math"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// Math is a module of transcendental functions that operate on any
+// `Numeric` (`Integer`, `Float`, `BigFloat`, `Rational`, or `Complex`),
+// always returning a `Float`. Arguments outside a function's domain (for
+// example `Math.sqrt(-1)` or `Math.log(0)`) raise `Math::DomainError`
+// rather than silently producing `NaN`.
+//
+// ```ruby
+// Math.sqrt(16)    # => 4.0
+// Math.sin(Math::PI / 2) # => 1.0
+// ```
+//
+// - `Math` is a module; it cannot be instantiated.
+var builtinMathClassMethods = []*BuiltinMethodObject{
+	{
+		// Returns the square root of a Numeric.
+		//
+		// @return [Float]
+		Name: "sqrt",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x < 0 {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "sqrt"`)
+			}
+			return t.vm.initFloatObject(math.Sqrt(x))
+		},
+	},
+	{
+		// Returns the cube root of a Numeric.
+		//
+		// @return [Float]
+		Name: "cbrt",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Cbrt(x))
+		},
+	},
+	{
+		// Returns e raised to the power of a Numeric.
+		//
+		// @return [Float]
+		Name: "exp",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Exp(x))
+		},
+	},
+	{
+		// Returns the natural logarithm of a Numeric.
+		//
+		// @return [Float]
+		Name: "log",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x <= 0 {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "log"`)
+			}
+			return t.vm.initFloatObject(math.Log(x))
+		},
+	},
+	{
+		// Returns the base-2 logarithm of a Numeric.
+		//
+		// @return [Float]
+		Name: "log2",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x <= 0 {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "log2"`)
+			}
+			return t.vm.initFloatObject(math.Log2(x))
+		},
+	},
+	{
+		// Returns the base-10 logarithm of a Numeric.
+		//
+		// @return [Float]
+		Name: "log10",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x <= 0 {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "log10"`)
+			}
+			return t.vm.initFloatObject(math.Log10(x))
+		},
+	},
+	{
+		// Returns the sine of a Numeric, in radians.
+		//
+		// @return [Float]
+		Name: "sin",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Sin(x))
+		},
+	},
+	{
+		// Returns the cosine of a Numeric, in radians.
+		//
+		// @return [Float]
+		Name: "cos",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Cos(x))
+		},
+	},
+	{
+		// Returns the tangent of a Numeric, in radians.
+		//
+		// @return [Float]
+		Name: "tan",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Tan(x))
+		},
+	},
+	{
+		// Returns the arcsine of a Numeric, in radians. The argument must be
+		// in the range -1..1.
+		//
+		// @return [Float]
+		Name: "asin",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x < -1 || x > 1 {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "asin"`)
+			}
+			return t.vm.initFloatObject(math.Asin(x))
+		},
+	},
+	{
+		// Returns the arccosine of a Numeric, in radians. The argument must be
+		// in the range -1..1.
+		//
+		// @return [Float]
+		Name: "acos",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x < -1 || x > 1 {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "acos"`)
+			}
+			return t.vm.initFloatObject(math.Acos(x))
+		},
+	},
+	{
+		// Returns the arctangent of a Numeric, in radians.
+		//
+		// @return [Float]
+		Name: "atan",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Atan(x))
+		},
+	},
+	{
+		// Returns the angle, in radians, between the positive x-axis and the
+		// point `(x, y)`.
+		//
+		// @return [Float]
+		Name: "atan2",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 2 arguments. got=%d", len(args))
+			}
+			y, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			x, ok := mathNumericArg(args, 1)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[1].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Atan2(y, x))
+		},
+	},
+	{
+		// Returns the hyperbolic sine of a Numeric.
+		//
+		// @return [Float]
+		Name: "sinh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Sinh(x))
+		},
+	},
+	{
+		// Returns the hyperbolic cosine of a Numeric.
+		//
+		// @return [Float]
+		Name: "cosh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Cosh(x))
+		},
+	},
+	{
+		// Returns the hyperbolic tangent of a Numeric.
+		//
+		// @return [Float]
+		Name: "tanh",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Tanh(x))
+		},
+	},
+	{
+		// Returns `sqrt(a**2 + b**2)`, the length of the hypotenuse of a right
+		// triangle with legs `a` and `b`.
+		//
+		// @return [Float]
+		Name: "hypot",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			if len(args) != 2 {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 2 arguments. got=%d", len(args))
+			}
+			a, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			b, ok := mathNumericArg(args, 1)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[1].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Hypot(a, b))
+		},
+	},
+	{
+		// Returns the gamma function of a Numeric.
+		//
+		// @return [Float]
+		Name: "gamma",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x <= 0 && x == math.Trunc(x) {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "gamma"`)
+			}
+			return t.vm.initFloatObject(math.Gamma(x))
+		},
+	},
+	{
+		// Returns the natural logarithm of the absolute value of the gamma
+		// function of a Numeric, as a two-element Array `[lg, sign]`, where
+		// `sign` is the sign of `Math.gamma(x)`.
+		//
+		// @return [Array]
+		Name: "lgamma",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			if x <= 0 && x == math.Trunc(x) {
+				return t.vm.InitErrorObject(errors.DomainError, sourceLine, `Numerical argument is out of domain for "lgamma"`)
+			}
+			lg, sign := math.Lgamma(x)
+			return t.vm.initArrayObject([]Object{t.vm.initFloatObject(lg), t.vm.InitIntegerObject(sign)})
+		},
+	},
+	{
+		// Returns the error function of a Numeric.
+		//
+		// @return [Float]
+		Name: "erf",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Erf(x))
+		},
+	},
+	{
+		// Returns the complementary error function of a Numeric.
+		//
+		// @return [Float]
+		Name: "erfc",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			x, ok := mathNumericArg(args, 0)
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+			return t.vm.initFloatObject(math.Erfc(x))
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+// mathNumericArg reads args[i] as a Numeric and returns its float64 value.
+func mathNumericArg(args []Object, i int) (float64, bool) {
+	if i >= len(args) {
+		return 0, false
+	}
+	n, ok := args[i].(Numeric)
+	if !ok {
+		return 0, false
+	}
+	return n.floatValue(), true
+}
+
+func (vm *VM) initMathClass() *RClass {
+	mc := vm.initializeModule(classes.MathClass)
+	mc.setBuiltinMethods(builtinMathClassMethods, true)
+	mc.constants["PI"] = &Pointer{Target: vm.initFloatObject(math.Pi)}
+	mc.constants["E"] = &Pointer{Target: vm.initFloatObject(math.E)}
+	mc.constants["TAU"] = &Pointer{Target: vm.initFloatObject(2 * math.Pi)}
+
+	dc := vm.initDomainErrorClass()
+	vm.objectClass.constants[dc.Name] = &Pointer{Target: dc}
+	mc.constants["DomainError"] = &Pointer{Target: dc}
+
+	return mc
+}
+
+// initDomainErrorClass registers `DomainError` as an actual class, the same
+// way initNumericExtensionClasses registers BigFloat/Rational/Complex/Math
+// themselves. Unlike TypeError or ArgumentError, DomainError isn't one of
+// the classes the core error bootstrap already knows about, so without this
+// InitErrorObject(errors.DomainError, ...) would be raising instances of a
+// class nobody ever created, and `rescue Math::DomainError` could never
+// match them.
+func (vm *VM) initDomainErrorClass() *RClass {
+	return vm.initializeClass(errors.DomainError)
+}
@@ -0,0 +1,16 @@
+package vm
+
+// initNumericExtensionClasses creates the BigFloat, Rational, Complex, and
+// Math classes added in the BigFloat/Rational/Complex/Math series and
+// installs them as top-level constants, the same way every other builtin
+// class is installed. Called once, from inside initFloatClass.
+func (vm *VM) initNumericExtensionClasses() {
+	for _, c := range []*RClass{
+		vm.initBigFloatClass(),
+		vm.initRationalClass(),
+		vm.initComplexClass(),
+		vm.initMathClass(),
+	} {
+		vm.objectClass.constants[c.Name] = &Pointer{Target: c}
+	}
+}
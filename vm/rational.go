@@ -0,0 +1,414 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/goby-lang/goby/vm/classes"
+	"github.com/goby-lang/goby/vm/errors"
+)
+
+// RationalObject represents an exact fraction of two integers, backed by
+// `math/big.Rat`. Unlike `Float` or `Decimal`, a `Rational` never loses
+// precision, even for non-terminating decimals like `1/3`.
+//
+// ```ruby
+// Rational.new(1, 3) + Rational.new(1, 6) # => 1/2
+// Rational.new("1/3")
+// ```
+//
+// - `Rational.new` creates a `Rational`; there is no `Rational()` function.
+type RationalObject struct {
+	*BaseObj
+	value *big.Rat
+}
+
+// Class methods --------------------------------------------------------
+var builtinRationalClassMethods = []*BuiltinMethodObject{
+	{
+		// Creates a `Rational` from a numerator and denominator `Integer` pair,
+		// or by parsing a "num/den" `String`.
+		//
+		// ```ruby
+		// Rational.new(1, 3) # => 1/3
+		// Rational.new("1/3") # => 1/3
+		// ```
+		//
+		// @return [Rational]
+		Name: "new",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			switch len(args) {
+			case 1:
+				s, ok := args[0].(*StringObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.StringClass, args[0].Class().Name)
+				}
+
+				r, ok := new(big.Rat).SetString(s.value)
+				if !ok {
+					return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, s.value)
+				}
+
+				return t.vm.initRationalObject(r)
+			case 2:
+				num, ok := args[0].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[0].Class().Name)
+				}
+
+				den, ok := args[1].(*IntegerObject)
+				if !ok {
+					return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, classes.IntegerClass, args[1].Class().Name)
+				}
+
+				if den.value == 0 {
+					return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+				}
+
+				return t.vm.initRationalObject(big.NewRat(int64(num.value), int64(den.value)))
+			default:
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, "Expect 1 or 2 arguments. got=%d", len(args))
+			}
+		},
+	},
+}
+
+// Instance methods -----------------------------------------------------
+var builtinRationalInstanceMethods = []*BuiltinMethodObject{
+	{
+		// Returns the sum of self and a Numeric.
+		//
+		// @return [Rational]
+		Name: "+",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Rat) *big.Rat {
+				return result.Add(left, right)
+			}
+
+			return receiver.(*RationalObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns the subtraction of a Numeric from self.
+		//
+		// @return [Rational]
+		Name: "-",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Rat) *big.Rat {
+				return result.Sub(left, right)
+			}
+
+			return receiver.(*RationalObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns self multiplied by a Numeric.
+		//
+		// @return [Rational]
+		Name: "*",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Rat) *big.Rat {
+				return result.Mul(left, right)
+			}
+
+			return receiver.(*RationalObject).arithmeticOperation(t, args[0], operation, sourceLine, false)
+		},
+	},
+	{
+		// Returns self divided by a Numeric.
+		//
+		// @return [Rational]
+		Name: "/",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			operation := func(result, left, right *big.Rat) *big.Rat {
+				return result.Quo(left, right)
+			}
+
+			return receiver.(*RationalObject).arithmeticOperation(t, args[0], operation, sourceLine, true)
+		},
+	},
+	{
+		// Compares self to a Numeric, returning -1, 0, or 1.
+		//
+		// @return [Integer]
+		Name: "<=>",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			cmp, ok := receiver.(*RationalObject).compare(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return t.vm.InitIntegerObject(cmp)
+		},
+	},
+	{
+		// Returns if self is smaller than a Numeric.
+		//
+		// @return [Boolean]
+		Name: "<",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			cmp, ok := receiver.(*RationalObject).compare(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(cmp < 0)
+		},
+	},
+	{
+		// Returns if self is smaller than or equal to a Numeric.
+		//
+		// @return [Boolean]
+		Name: "<=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			cmp, ok := receiver.(*RationalObject).compare(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(cmp <= 0)
+		},
+	},
+	{
+		// Returns if self is larger than a Numeric.
+		//
+		// @return [Boolean]
+		Name: ">",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			cmp, ok := receiver.(*RationalObject).compare(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(cmp > 0)
+		},
+	},
+	{
+		// Returns if self is larger than or equal to a Numeric.
+		//
+		// @return [Boolean]
+		Name: ">=",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			cmp, ok := receiver.(*RationalObject).compare(args[0])
+			if !ok {
+				return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", args[0].Class().Name)
+			}
+
+			return toBooleanObject(cmp >= 0)
+		},
+	},
+	{
+		// Returns the numerator of self, in lowest terms.
+		//
+		// @return [Integer]
+		Name: "numerator",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.InitIntegerObject(int(receiver.(*RationalObject).value.Num().Int64()))
+		},
+	},
+	{
+		// Returns the denominator of self, in lowest terms.
+		//
+		// @return [Integer]
+		Name: "denominator",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			return t.vm.InitIntegerObject(int(receiver.(*RationalObject).value.Denom().Int64()))
+		},
+	},
+	{
+		// Returns the reciprocal of self.
+		//
+		// ```ruby
+		// Rational.new(3, 4).reciprocal # => 4/3
+		// ```
+		//
+		// @return [Rational]
+		Name: "reciprocal",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*RationalObject).value
+			if r.Sign() == 0 {
+				return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+			}
+
+			result := new(big.Rat).Inv(r)
+			return t.vm.initRationalObject(result)
+		},
+	},
+	{
+		// Returns self as a positive value.
+		//
+		// @return [Rational]
+		Name: "abs",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			result := new(big.Rat).Abs(receiver.(*RationalObject).value)
+			return t.vm.initRationalObject(result)
+		},
+	},
+	{
+		// Returns the `Float` representation of self, which may lose precision.
+		//
+		// @return [Float]
+		Name: "to_f",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			f, _ := receiver.(*RationalObject).value.Float64()
+			return t.vm.initFloatObject(f)
+		},
+	},
+	{
+		// Returns the `Integer` representation of self, truncating towards zero.
+		//
+		// @return [Integer]
+		Name: "to_i",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*RationalObject).value
+			q := new(big.Int).Quo(r.Num(), r.Denom())
+			return t.vm.InitIntegerObject(int(q.Int64()))
+		},
+	},
+	{
+		// Returns the `Decimal` representation of self.
+		//
+		// @return [Decimal]
+		Name: "to_d",
+		Fn: func(receiver Object, sourceLine int, t *Thread, args []Object, blockFrame *normalCallFrame) Object {
+			r := receiver.(*RationalObject).value
+			fs := new(big.Float).SetPrec(256).SetRat(r).Text('f', -1)
+			de, ok := new(Decimal).SetString(fs)
+			if ok == false {
+				return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, fs)
+			}
+
+			return t.vm.initDecimalObject(de)
+		},
+	},
+}
+
+// Internal functions ===================================================
+
+// Functions for initialization -----------------------------------------
+
+func (vm *VM) initRationalObject(value *big.Rat) *RationalObject {
+	return &RationalObject{
+		BaseObj: NewBaseObject(vm.TopLevelClass(classes.RationalClass)),
+		value:   value,
+	}
+}
+
+func (vm *VM) initRationalClass() *RClass {
+	rc := vm.initializeClass(classes.RationalClass)
+	rc.setBuiltinMethods(builtinRationalInstanceMethods, false)
+	rc.setBuiltinMethods(builtinRationalClassMethods, true)
+	return rc
+}
+
+// Polymorphic helper functions -----------------------------------------
+
+// Value returns the object
+func (r *RationalObject) Value() interface{} {
+	return r.value
+}
+
+// Numeric interface
+func (r *RationalObject) floatValue() float64 {
+	f, _ := r.value.Float64()
+	return f
+}
+
+// toBigRat coerces a Rational or Integer into a *big.Rat. Float is
+// intentionally excluded here: arithmeticOperation and compare handle a
+// Float right-hand side themselves, and a Float receiver goes through
+// FloatObject.arithmeticOperation.
+func toBigRat(obj Object) (*big.Rat, bool) {
+	switch v := obj.(type) {
+	case *RationalObject:
+		return v.value, true
+	case *IntegerObject:
+		return new(big.Rat).SetInt64(int64(v.value)), true
+	default:
+		return nil, false
+	}
+}
+
+// arithmeticOperation applies the passed arithmetic operation, coercing the
+// right-hand side. A Float on the right is converted exactly to a Rational,
+// so the result stays a Rational: the receiver's type wins. (A Float
+// receiver with a Rational argument gives a Float, via
+// FloatObject.arithmeticOperation.) A NaN or infinite Float raises an
+// ArgumentError, since it has no Rational value.
+func (r *RationalObject) arithmeticOperation(t *Thread, rightObject Object, operation func(result, left, right *big.Rat) *big.Rat, sourceLine int, division bool) Object {
+	rightValue, ok := toBigRat(rightObject)
+	if !ok {
+		rightFloat, isFloat := rightObject.(*FloatObject)
+		if !isFloat {
+			return t.vm.InitErrorObject(errors.TypeError, sourceLine, errors.WrongArgumentTypeFormat, "Numeric", rightObject.Class().Name)
+		}
+
+		rightValue = new(big.Rat).SetFloat64(rightFloat.value)
+		if rightValue == nil {
+			return t.vm.InitErrorObject(errors.ArgumentError, sourceLine, errors.InvalidNumericString, rightFloat.ToString())
+		}
+	}
+
+	if division && rightValue.Sign() == 0 {
+		return t.vm.InitErrorObject(errors.ZeroDivisionError, sourceLine, errors.DividedByZero)
+	}
+
+	result := operation(new(big.Rat), r.value, rightValue)
+
+	return t.vm.initRationalObject(result)
+}
+
+// compare returns -1, 0, or 1 comparing self to a Numeric, demoting self to
+// Float first when the right-hand side is a Float.
+func (r *RationalObject) compare(rightObject Object) (int, bool) {
+	if rightFloat, ok := rightObject.(*FloatObject); ok {
+		leftValue, _ := r.value.Float64()
+		rightValue := rightFloat.value
+
+		switch {
+		case leftValue < rightValue:
+			return -1, true
+		case leftValue > rightValue:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	rightValue, ok := toBigRat(rightObject)
+	if !ok {
+		return 0, false
+	}
+
+	return r.value.Cmp(rightValue), true
+}
+
+// equalTo returns true if the objects are considered numerically equal.
+func (r *RationalObject) equalTo(rightObject Object) bool {
+	rightValue, ok := toBigRat(rightObject)
+	if !ok {
+		return false
+	}
+
+	return r.value.Cmp(rightValue) == 0
+}
+
+func (r *RationalObject) lessThan(arg Object) bool {
+	cmp, ok := r.compare(arg)
+	return ok && cmp < 0
+}
+
+// ToString returns the object's value as "numerator/denominator", in lowest
+// terms.
+func (r *RationalObject) ToString() string {
+	return r.value.String()
+}
+
+// Inspect delegates to ToString
+func (r *RationalObject) Inspect() string {
+	return r.ToString()
+}
+
+// ToJSON just delegates to ToString
+func (r *RationalObject) ToJSON(t *Thread) string {
+	return r.ToString()
+}